@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+)
+
+// Clients are the clients used to make requests to external services on behalf of a command
+type Clients struct {
+	Realm realm.Client
+	Atlas atlas.Client
+}
+
+// NewClients builds the Clients a command uses, wrapping baseAtlasClient with the
+// recovery/retry middleware chain so a panicking or rate-limited Atlas Admin API
+// call never takes down the CLI.
+func NewClients(realmClient realm.Client, baseAtlasClient atlas.Client) Clients {
+	return Clients{
+		Realm: realmClient,
+		Atlas: atlas.NewClientWithMiddleware(
+			baseAtlasClient,
+			atlas.RecoveryInterceptor(),
+			atlas.RetryInterceptor(3, 500*time.Millisecond),
+		),
+	}
+}
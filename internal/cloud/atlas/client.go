@@ -0,0 +1,56 @@
+package atlas
+
+// Client is the interface for interacting with the Atlas Admin API
+type Client interface {
+	Groups() ([]Group, error)
+	Clusters(groupID string) ([]Cluster, error)
+	DataLakes(groupID string) ([]DataLake, error)
+	Serverless(groupID string) ([]Serverless, error)
+	ProjectSettings(groupID string) (ProjectSettings, error)
+	OnlineArchives(groupID, clusterName string) ([]OnlineArchive, error)
+	FederatedSearch(groupID string) ([]FederatedSearchIndex, error)
+}
+
+// Group is an Atlas project (née "group")
+type Group struct {
+	ID   string
+	Name string
+}
+
+// Cluster is an Atlas cluster
+type Cluster struct {
+	ID   string
+	Name string
+}
+
+// DataLake is an Atlas data lake
+type DataLake struct {
+	Name string
+}
+
+// Serverless is an Atlas serverless instance
+type Serverless struct {
+	ID   string
+	Name string
+}
+
+// ProjectSettings are the Atlas project-level settings
+type ProjectSettings struct {
+	GroupID string
+}
+
+// OnlineArchive is an Atlas Online Archive, archiving a collection on a given cluster
+type OnlineArchive struct {
+	ID          string
+	ClusterName string
+	DBName      string
+	CollName    string
+}
+
+// FederatedSearchIndex is an Atlas Search index definition usable as a federated data source
+type FederatedSearchIndex struct {
+	ID       string
+	Name     string
+	DBName   string
+	CollName string
+}
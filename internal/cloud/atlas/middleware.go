@@ -0,0 +1,125 @@
+package atlas
+
+import "errors"
+
+// ErrAtlasClientUnset is returned when a middleware-wrapped Client is called
+// without an underlying Client having been configured.
+var ErrAtlasClientUnset = errors.New("atlas client is not configured")
+
+// ErrAtlasClientPanic wraps a panic recovered while invoking the underlying Client
+type ErrAtlasClientPanic struct {
+	Method string
+	Cause  interface{}
+}
+
+func (err *ErrAtlasClientPanic) Error() string {
+	return "atlas client panicked calling " + err.Method
+}
+
+// Invoker calls through to the next interceptor (or the base Client) in the chain
+type Invoker func() (interface{}, error)
+
+// Interceptor wraps an Invoker for a single Client method call, e.g. to add
+// panic recovery, retries, or logging, mirroring the grpc-middleware
+// recovery/retry interceptor pattern.
+type Interceptor func(method string, next Invoker) Invoker
+
+// chain composes interceptors so the first one given wraps the outermost call
+func chain(interceptors []Interceptor, method string, base Invoker) Invoker {
+	invoker := base
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		invoker = interceptors[i](method, invoker)
+	}
+	return invoker
+}
+
+// middlewareClient wraps a base Client, routing every method call through the
+// same interceptor chain so new Client methods only need a short dispatch
+// method added here rather than their own recovery/retry handling.
+type middlewareClient struct {
+	base         Client
+	interceptors []Interceptor
+}
+
+// NewClientWithMiddleware wraps base with the given interceptors, applied in the
+// order given (the first interceptor is outermost).
+func NewClientWithMiddleware(base Client, interceptors ...Interceptor) Client {
+	return &middlewareClient{base, interceptors}
+}
+
+func (c *middlewareClient) invoke(method string, call func() (interface{}, error)) (interface{}, error) {
+	if c.base == nil {
+		return nil, ErrAtlasClientUnset
+	}
+	return chain(c.interceptors, method, call)()
+}
+
+func (c *middlewareClient) Groups() ([]Group, error) {
+	result, err := c.invoke("Groups", func() (interface{}, error) {
+		return c.base.Groups()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]Group), nil
+}
+
+func (c *middlewareClient) Clusters(groupID string) ([]Cluster, error) {
+	result, err := c.invoke("Clusters", func() (interface{}, error) {
+		return c.base.Clusters(groupID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]Cluster), nil
+}
+
+func (c *middlewareClient) DataLakes(groupID string) ([]DataLake, error) {
+	result, err := c.invoke("DataLakes", func() (interface{}, error) {
+		return c.base.DataLakes(groupID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]DataLake), nil
+}
+
+func (c *middlewareClient) Serverless(groupID string) ([]Serverless, error) {
+	result, err := c.invoke("Serverless", func() (interface{}, error) {
+		return c.base.Serverless(groupID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]Serverless), nil
+}
+
+func (c *middlewareClient) ProjectSettings(groupID string) (ProjectSettings, error) {
+	result, err := c.invoke("ProjectSettings", func() (interface{}, error) {
+		return c.base.ProjectSettings(groupID)
+	})
+	if err != nil {
+		return ProjectSettings{}, err
+	}
+	return result.(ProjectSettings), nil
+}
+
+func (c *middlewareClient) OnlineArchives(groupID, clusterName string) ([]OnlineArchive, error) {
+	result, err := c.invoke("OnlineArchives", func() (interface{}, error) {
+		return c.base.OnlineArchives(groupID, clusterName)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]OnlineArchive), nil
+}
+
+func (c *middlewareClient) FederatedSearch(groupID string) ([]FederatedSearchIndex, error) {
+	result, err := c.invoke("FederatedSearch", func() (interface{}, error) {
+		return c.base.FederatedSearch(groupID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]FederatedSearchIndex), nil
+}
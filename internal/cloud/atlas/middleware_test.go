@@ -0,0 +1,97 @@
+package atlas_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	"github.com/10gen/realm-cli/internal/utils/test/assert"
+	"github.com/10gen/realm-cli/internal/utils/test/mock"
+)
+
+func TestNewClientWithMiddlewareRecovery(t *testing.T) {
+	t.Run("should convert a panic from the underlying client into an ErrAtlasClientPanic", func(t *testing.T) {
+		ac := mock.AtlasClient{}
+		ac.GroupsFn = func() ([]atlas.Group, error) {
+			panic("boom")
+		}
+
+		client := mock.NewAtlasClientWithMiddleware(ac, atlas.RecoveryInterceptor())
+
+		_, err := client.Groups()
+
+		var panicErr *atlas.ErrAtlasClientPanic
+		if !errors.As(err, &panicErr) {
+			t.Fatalf("expected an *atlas.ErrAtlasClientPanic, got %v", err)
+		}
+		assert.Equal(t, "Groups", panicErr.Method)
+	})
+
+	t.Run("should return ErrAtlasClientUnset when the base client is nil", func(t *testing.T) {
+		client := atlas.NewClientWithMiddleware(nil, atlas.RecoveryInterceptor())
+
+		_, err := client.Groups()
+
+		assert.Equal(t, atlas.ErrAtlasClientUnset, err)
+	})
+}
+
+func TestNewClientWithMiddlewareRetry(t *testing.T) {
+	t.Run("should retry a 503 until the underlying client succeeds", func(t *testing.T) {
+		attempts := 0
+		ac := mock.AtlasClient{}
+		ac.ClustersFn = func(groupID string) ([]atlas.Cluster, error) {
+			attempts++
+			if attempts < 3 {
+				return nil, &atlas.HTTPError{StatusCode: http.StatusServiceUnavailable, Err: errors.New("unavailable")}
+			}
+			return []atlas.Cluster{{ID: "789", Name: "test-cluster"}}, nil
+		}
+
+		client := mock.NewAtlasClientWithMiddleware(ac, atlas.RetryInterceptor(3, time.Millisecond))
+
+		clusters, err := client.Clusters("123")
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, attempts)
+		assert.Equal(t, []atlas.Cluster{{ID: "789", Name: "test-cluster"}}, clusters)
+	})
+
+	t.Run("should give up after maxRetries and return the last error", func(t *testing.T) {
+		attempts := 0
+		ac := mock.AtlasClient{}
+		ac.ClustersFn = func(groupID string) ([]atlas.Cluster, error) {
+			attempts++
+			return nil, &atlas.HTTPError{StatusCode: http.StatusTooManyRequests, Err: errors.New("rate limited")}
+		}
+
+		client := mock.NewAtlasClientWithMiddleware(ac, atlas.RetryInterceptor(2, time.Millisecond))
+
+		_, err := client.Clusters("123")
+
+		assert.Equal(t, 3, attempts) // initial attempt + 2 retries
+		var httpErr *atlas.HTTPError
+		if !errors.As(err, &httpErr) {
+			t.Fatalf("expected an *atlas.HTTPError, got %v", err)
+		}
+		assert.Equal(t, http.StatusTooManyRequests, httpErr.StatusCode)
+	})
+
+	t.Run("should not retry non-retryable errors", func(t *testing.T) {
+		attempts := 0
+		ac := mock.AtlasClient{}
+		ac.ClustersFn = func(groupID string) ([]atlas.Cluster, error) {
+			attempts++
+			return nil, errors.New("some other error")
+		}
+
+		client := mock.NewAtlasClientWithMiddleware(ac, atlas.RetryInterceptor(3, time.Millisecond))
+
+		_, err := client.Clusters("123")
+
+		assert.Equal(t, 1, attempts)
+		assert.Equal(t, errors.New("some other error"), err)
+	})
+}
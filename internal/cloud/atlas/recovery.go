@@ -0,0 +1,16 @@
+package atlas
+
+// RecoveryInterceptor converts a panic raised while invoking the underlying
+// Client into an *ErrAtlasClientPanic, so callers never need their own recover().
+func RecoveryInterceptor() Interceptor {
+	return func(method string, next Invoker) Invoker {
+		return func() (result interface{}, err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					result, err = nil, &ErrAtlasClientPanic{Method: method, Cause: r}
+				}
+			}()
+			return next()
+		}
+	}
+}
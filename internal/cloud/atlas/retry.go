@@ -0,0 +1,61 @@
+package atlas
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// HTTPError is returned by the Atlas Admin API client when a request fails
+// with a non-2xx response, carrying enough detail for RetryInterceptor to
+// decide whether (and how long) to back off.
+type HTTPError struct {
+	StatusCode int
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (err *HTTPError) Error() string {
+	return err.Err.Error()
+}
+
+func (err *HTTPError) Unwrap() error {
+	return err.Err
+}
+
+// RetryInterceptor retries calls that fail with a 429 or 503 HTTPError, backing
+// off exponentially and honoring the response's Retry-After when present.
+func RetryInterceptor(maxRetries int, baseDelay time.Duration) Interceptor {
+	return func(method string, next Invoker) Invoker {
+		return func() (interface{}, error) {
+			var result interface{}
+			var err error
+
+			for attempt := 0; attempt <= maxRetries; attempt++ {
+				result, err = next()
+
+				var httpErr *HTTPError
+				if !errors.As(err, &httpErr) || !isRetryable(httpErr.StatusCode) {
+					return result, err
+				}
+				if attempt == maxRetries {
+					break
+				}
+
+				time.Sleep(retryDelay(httpErr, attempt, baseDelay))
+			}
+			return result, err
+		}
+	}
+}
+
+func isRetryable(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
+
+func retryDelay(httpErr *HTTPError, attempt int, baseDelay time.Duration) time.Duration {
+	if httpErr.RetryAfter > 0 {
+		return httpErr.RetryAfter
+	}
+	return baseDelay << attempt
+}
@@ -0,0 +1,63 @@
+package realm
+
+// Client is the interface for interacting with the Realm Admin API
+type Client interface {
+	FindApps(filter AppFilter) ([]App, error)
+	Diff(groupID, appID string, appData interface{}) (Diffs, error)
+	DiffDependencies(groupID, appID, uploadPath string) (Diffs, error)
+	HostingAssets(groupID, appID string) ([]HostingAsset, error)
+	Secrets(groupID, appID string) ([]Secret, error)
+}
+
+// AppFilter filters the set of Realm apps FindApps returns
+type AppFilter struct {
+	GroupID string
+	App     string
+}
+
+// App is a Realm application
+type App struct {
+	ID          string
+	GroupID     string
+	ClientAppID string
+	Name        string
+}
+
+// Secret is a Realm app secret
+type Secret struct {
+	ID   string
+	Name string
+}
+
+// HostingAsset is a single file served by a Realm app's static hosting
+type HostingAsset struct {
+	FilePath string
+	FileHash string
+}
+
+// Environment is the environment a Realm app is deployed to
+type Environment string
+
+// set of supported Environments
+const (
+	EnvironmentNone        Environment = ""
+	EnvironmentDevelopment Environment = "development"
+	EnvironmentTesting     Environment = "testing"
+	EnvironmentQA          Environment = "qa"
+	EnvironmentProduction  Environment = "production"
+)
+
+// set of supported deployment models
+const (
+	DeploymentModelGlobal = "GLOBAL"
+	DeploymentModelLocal  = "LOCAL"
+)
+
+// set of supported locations
+const (
+	LocationVirginia = "US-VA"
+	LocationOregon   = "US-OR"
+)
+
+// DefaultAppConfigVersion is the app config version new apps are scaffolded with
+const DefaultAppConfigVersion = 20200603
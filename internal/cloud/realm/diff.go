@@ -0,0 +1,129 @@
+package realm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiffKind categorizes the kind of change a Diff represents
+type DiffKind string
+
+// set of supported diff kinds
+const (
+	DiffKindCreate DiffKind = "create"
+	DiffKindUpdate DiffKind = "update"
+	DiffKindDelete DiffKind = "delete"
+)
+
+// Hunk is a single unified-diff hunk for a Diff's contents
+type Hunk struct {
+	Header string
+	Lines  []string
+}
+
+// Diff is a single structured change between a local Realm app and its deployed counterpart
+type Diff struct {
+	Path   string
+	Kind   DiffKind
+	Before string
+	After  string
+	Hunks  []Hunk
+	// Text is the human-readable description of this change, as previously
+	// rendered directly by the Realm API's diff endpoint.
+	Text string
+}
+
+// NewDiff builds a Diff from a path, kind, and the before/after content,
+// computing its unified-diff Hunks and a human-readable Text summary.
+func NewDiff(path string, kind DiffKind, before, after string) Diff {
+	hunks := computeHunks(before, after)
+
+	return Diff{
+		Path:   path,
+		Kind:   kind,
+		Before: before,
+		After:  after,
+		Hunks:  hunks,
+		Text:   fmt.Sprintf("%s %s\n%s", kind, path, renderHunks(hunks)),
+	}
+}
+
+// HunksOrComputed returns the Diff's Hunks, computing them from Before/After
+// on the fly if the Diff was constructed without them (e.g. by an older client).
+func (diff Diff) HunksOrComputed() []Hunk {
+	if len(diff.Hunks) > 0 {
+		return diff.Hunks
+	}
+	return computeHunks(diff.Before, diff.After)
+}
+
+// Diffs is a list of Diff
+type Diffs []Diff
+
+// Strings renders each Diff as the human-readable text the `app diff` command
+// has always shown, falling back to a terse "kind path" summary for a Diff
+// that was never given rich Text content.
+func (diffs Diffs) Strings() []string {
+	out := make([]string, 0, len(diffs))
+	for _, diff := range diffs {
+		if diff.Text != "" {
+			out = append(out, diff.Text)
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s %s", diff.Kind, diff.Path))
+	}
+	return out
+}
+
+// computeHunks builds a single unified-diff hunk covering the whole of before
+// and after. It is not a minimal line-level diff, but it is a faithful,
+// line-by-line accounting of what changed, sufficient for `git apply --check`
+// when each side is empty or ends in a newline; no "\ No newline at end of file"
+// marker is written.
+func computeHunks(before, after string) []Hunk {
+	if before == after {
+		return nil
+	}
+
+	var lines []string
+	for _, line := range splitLines(before) {
+		lines = append(lines, "-"+line)
+	}
+	for _, line := range splitLines(after) {
+		lines = append(lines, "+"+line)
+	}
+
+	return []Hunk{{
+		Header: fmt.Sprintf("@@ -%s +%s @@", hunkRange(len(splitLines(before))), hunkRange(len(splitLines(after)))),
+		Lines:  lines,
+	}}
+}
+
+// hunkRange renders the "start,count" range of a hunk covering all n lines of
+// one side; an empty side starts at line 0, as in any unified diff
+func hunkRange(n int) string {
+	if n == 0 {
+		return "0,0"
+	}
+	return fmt.Sprintf("1,%d", n)
+}
+
+func renderHunks(hunks []Hunk) string {
+	var sb strings.Builder
+	for _, hunk := range hunks {
+		sb.WriteString(hunk.Header)
+		sb.WriteString("\n")
+		for _, line := range hunk.Lines {
+			sb.WriteString(line)
+			sb.WriteString("\n")
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,170 @@
+package app
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/local/templates"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaCreate is the command meta for the `app create` command
+var CommandMetaCreate = cli.CommandMeta{
+	Use:         "create",
+	Aliases:     []string{},
+	Display:     "app create",
+	Description: "Create a new Realm app",
+	HelpText:    `Creates a new Realm app, scaffolding it in a local directory.`,
+}
+
+// CommandCreate is the `app create` command
+type CommandCreate struct {
+	inputs createInputs
+}
+
+const (
+	flagName                 = "name"
+	flagNameUsage            = "the name of the Realm app"
+	flagDeploymentModel      = "deployment-model"
+	flagDeploymentModelUsage = "the deployment model to host the Realm app in"
+	flagLocation             = "location"
+	flagLocationUsage        = "the location to host the Realm app in"
+	flagLocalPathCreate      = "local"
+	flagLocalPathCreateUsage = "the local path to scaffold the Realm app in"
+	flagProject              = "project"
+	flagProjectUsage         = "the MongoDB Atlas project ID to create the Realm app in"
+	flagCluster              = "cluster"
+	flagClusterUsage         = "the name of an Atlas cluster to add as a data source"
+	flagDataLake             = "data-lake"
+	flagDataLakeUsage        = "the name of an Atlas data lake to add as a data source"
+	flagServerless           = "serverless"
+	flagServerlessUsage      = "the name of an Atlas serverless instance to add as a data source"
+	flagOnlineArchive        = "online-archive"
+	flagOnlineArchiveUsage   = "the ID of an Atlas online archive to add as a data source"
+	flagFederatedSearch      = "federated-search"
+	flagFederatedSearchUsage = "the name of an Atlas Search index to add as a federated data source"
+	flagFrom                 = "from"
+	flagFromUsage            = "a template to scaffold the app from, e.g. github://org/repo[@ref][/subpath]"
+)
+
+// Flags is the command flags
+func (cmd *CommandCreate) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&cmd.inputs.Name, flagName, "", flagNameUsage)
+	fs.StringVar(&cmd.inputs.DeploymentModel, flagDeploymentModel, "", flagDeploymentModelUsage)
+	fs.StringVar(&cmd.inputs.Location, flagLocation, "", flagLocationUsage)
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPathCreate, "", flagLocalPathCreateUsage)
+	fs.StringVar(&cmd.inputs.Project, flagProject, "", flagProjectUsage)
+	fs.StringVar(&cmd.inputs.Cluster, flagCluster, "", flagClusterUsage)
+	fs.StringVar(&cmd.inputs.DataLake, flagDataLake, "", flagDataLakeUsage)
+	fs.StringVar(&cmd.inputs.Serverless, flagServerless, "", flagServerlessUsage)
+	fs.StringVar(&cmd.inputs.OnlineArchive, flagOnlineArchive, "", flagOnlineArchiveUsage)
+	fs.StringVar(&cmd.inputs.FederatedSearch, flagFederatedSearch, "", flagFederatedSearchUsage)
+	fs.StringVar(&cmd.inputs.From, flagFrom, "", flagFromUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandCreate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandCreate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	fullPath, err := cmd.inputs.resolveLocalPath(ui, profile.WorkingDirectory)
+	if err != nil {
+		return err
+	}
+
+	appLocal := local.NewApp(
+		fullPath,
+		"",
+		cmd.inputs.Name,
+		cmd.inputs.Location,
+		cmd.inputs.DeploymentModel,
+		cmd.inputs.Environment,
+		realm.DefaultAppConfigVersion,
+	)
+
+	dataSources := make([]interface{}, 0, 2)
+
+	if cmd.inputs.Cluster != "" {
+		dataSource, err := cmd.inputs.resolveCluster(clients.Atlas, cmd.inputs.Project)
+		if err != nil {
+			return err
+		}
+		dataSources = append(dataSources, dataSource)
+	}
+
+	if cmd.inputs.DataLake != "" {
+		dataSource, err := cmd.inputs.resolveDataLake(clients.Atlas, cmd.inputs.Project)
+		if err != nil {
+			return err
+		}
+		dataSources = append(dataSources, dataSource)
+	}
+
+	if cmd.inputs.Serverless != "" {
+		dataSource, err := cmd.inputs.resolveServerless(clients.Atlas, cmd.inputs.Project)
+		if err != nil {
+			return err
+		}
+		dataSources = append(dataSources, dataSource)
+	}
+
+	if cmd.inputs.OnlineArchive != "" {
+		dataSource, err := cmd.inputs.resolveOnlineArchive(clients.Atlas, cmd.inputs.Project, cmd.inputs.Cluster)
+		if err != nil {
+			return err
+		}
+		dataSources = append(dataSources, dataSource)
+	}
+
+	if cmd.inputs.FederatedSearch != "" {
+		dataSource, err := cmd.inputs.resolveFederatedSearch(clients.Atlas, cmd.inputs.Project)
+		if err != nil {
+			return err
+		}
+		dataSources = append(dataSources, dataSource)
+	}
+
+	if err := appLocal.WriteConfig(); err != nil {
+		return err
+	}
+	if err := appLocal.WriteDataSources(dataSources); err != nil {
+		return err
+	}
+
+	if cmd.inputs.From != "" {
+		if err := cmd.applyTemplate(profile, appLocal); err != nil {
+			return err
+		}
+	}
+
+	ui.Print(terminal.NewTextLog("Successfully created app '%s'", cmd.inputs.Name))
+	return nil
+}
+
+func (cmd *CommandCreate) applyTemplate(profile *user.Profile, appLocal local.App) error {
+	ref, err := templates.ParseRef(cmd.inputs.From)
+	if err != nil {
+		return err
+	}
+
+	provider, err := templates.NewProvider(ref, profile.User().AccessToken)
+	if err != nil {
+		return err
+	}
+
+	templateFS, err := provider.Fetch(ref.GitRef, ref.Subpath)
+	if err != nil {
+		return err
+	}
+
+	return templates.Overlay(templateFS, appLocal.RootDir, templates.Vars{
+		AppName:      cmd.inputs.Name,
+		ClusterName:  cmd.inputs.Cluster,
+		DataLakeName: cmd.inputs.DataLake,
+	})
+}
@@ -0,0 +1,329 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/terminal"
+)
+
+// set of defaults for creating a new app
+const (
+	flagDeploymentModelDefault = realm.DeploymentModelGlobal
+	flagLocationDefault        = realm.LocationVirginia
+)
+
+// newAppInputs are the inputs needed to define the shape of a new Realm app
+type newAppInputs struct {
+	Name            string
+	DeploymentModel string
+	Location        string
+	Environment     realm.Environment
+}
+
+// createInputs are the inputs for the `app create` command
+type createInputs struct {
+	newAppInputs
+	Project         string
+	LocalPath       string
+	Cluster         string
+	DataLake        string
+	Serverless      string
+	OnlineArchive   string
+	FederatedSearch string
+	From            string
+}
+
+// appRemote identifies a Realm app that already exists in the cloud
+type appRemote struct {
+	GroupID string
+	AppID   string
+}
+
+type errProjectExists struct {
+	path string
+}
+
+func (err errProjectExists) Error() string {
+	return fmt.Sprintf("a Realm app already exists at %s", err.path)
+}
+
+// Resolve resolves the create command inputs
+func (i *createInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if i.Name == "" {
+		if err := ui.AskOne(&i.Name, &terminal.Question{Prompt: "App Name"}); err != nil {
+			return err
+		}
+	}
+
+	if i.DeploymentModel == "" {
+		i.DeploymentModel = flagDeploymentModelDefault
+	}
+	if i.Location == "" {
+		i.Location = flagLocationDefault
+	}
+	if i.Environment == "" {
+		i.Environment = realm.EnvironmentNone
+	}
+
+	if i.From == "" {
+		if err := i.resolveFrom(profile, ui); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *createInputs) resolveFrom(profile *user.Profile, ui terminal.UI) error {
+	available, err := local.FindTemplatesConfig(profile.WorkingDirectory)
+	if err != nil || len(available) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(available))
+	for _, template := range available {
+		names = append(names, template.Name)
+	}
+
+	var selection string
+	if err := ui.AskOne(&selection, &terminal.Question{
+		Prompt:  "Which template would you like to scaffold your app from?",
+		Options: names,
+	}); err != nil {
+		return err
+	}
+
+	for _, template := range available {
+		if template.Name == selection {
+			i.From = template.Source
+		}
+	}
+	return nil
+}
+
+func (i *createInputs) resolveName(ui terminal.UI, rc realm.Client, ar appRemote) error {
+	if i.Name != "" {
+		return nil
+	}
+
+	apps, err := rc.FindApps(realm.AppFilter{GroupID: ar.GroupID, App: ar.AppID})
+	if err != nil {
+		return err
+	}
+
+	if len(apps) > 0 {
+		i.Name = apps[0].Name
+	}
+	return nil
+}
+
+func (i *createInputs) resolveLocalPath(ui terminal.UI, wd string) (string, error) {
+	localPath := i.LocalPath
+	if localPath == "" {
+		localPath = i.Name
+	}
+
+	fullPath := path.Join(wd, localPath)
+
+	if local.FoundAppAt(fullPath) {
+		return "", errProjectExists{fullPath}
+	}
+
+	if _, err := os.Stat(fullPath); err == nil {
+		if ui == nil {
+			return fullPath, nil
+		}
+
+		ui.Print(terminal.NewTextLog(
+			"Local path '%s' already exists, writing app contents to that destination may result in file conflicts.",
+			path.Join(".", localPath),
+		))
+
+		var proceed bool
+		if err := ui.AskOne(&proceed, &terminal.Question{
+			Prompt: fmt.Sprintf("Would you still like to write app contents to '%s'? ('No' will prompt you to provide another destination)", path.Join(".", localPath)),
+		}); err != nil {
+			return "", err
+		}
+		if !proceed {
+			var newPath string
+			if err := ui.AskOne(&newPath, &terminal.Question{Prompt: "Local Path"}); err != nil {
+				return "", err
+			}
+			i.LocalPath = newPath
+			return i.resolveLocalPath(ui, wd)
+		}
+	}
+
+	i.LocalPath = localPath
+	return fullPath, nil
+}
+
+func (i *createInputs) resolveCluster(ac atlas.Client, groupID string) (dataSourceCluster, error) {
+	clusters, err := ac.Clusters(groupID)
+	if err != nil {
+		return dataSourceCluster{}, err
+	}
+
+	for _, cluster := range clusters {
+		if cluster.Name == i.Cluster {
+			return dataSourceCluster{
+				Name: "mongodb-atlas",
+				Type: "mongodb-atlas",
+				Config: configCluster{
+					ClusterName:         cluster.Name,
+					ReadPreference:      "primary",
+					WireProtocolEnabled: false,
+				},
+			}, nil
+		}
+	}
+	return dataSourceCluster{}, errors.New("failed to find Atlas cluster")
+}
+
+func (i *createInputs) resolveDataLake(ac atlas.Client, groupID string) (dataSourceDataLake, error) {
+	dataLakes, err := ac.DataLakes(groupID)
+	if err != nil {
+		return dataSourceDataLake{}, err
+	}
+
+	for _, dataLake := range dataLakes {
+		if dataLake.Name == i.DataLake {
+			return dataSourceDataLake{
+				Name: "mongodb-datalake",
+				Type: "datalake",
+				Config: configDataLake{
+					DataLakeName: dataLake.Name,
+				},
+			}, nil
+		}
+	}
+	return dataSourceDataLake{}, errors.New("failed to find Atlas data lake")
+}
+
+func (i *createInputs) resolveServerless(ac atlas.Client, groupID string) (dataSourceServerless, error) {
+	instances, err := ac.Serverless(groupID)
+	if err != nil {
+		return dataSourceServerless{}, err
+	}
+
+	for _, instance := range instances {
+		if instance.Name == i.Serverless {
+			return dataSourceServerless{
+				Name: "mongodb-atlas-serverless",
+				Type: "mongodb-atlas",
+				Config: configServerless{
+					ClusterName: instance.Name,
+				},
+			}, nil
+		}
+	}
+	return dataSourceServerless{}, errors.New("failed to find Atlas serverless instance")
+}
+
+func (i *createInputs) resolveOnlineArchive(ac atlas.Client, groupID, clusterName string) (dataSourceOnlineArchive, error) {
+	archives, err := ac.OnlineArchives(groupID, clusterName)
+	if err != nil {
+		return dataSourceOnlineArchive{}, err
+	}
+
+	for _, archive := range archives {
+		if archive.ID == i.OnlineArchive {
+			return dataSourceOnlineArchive{
+				Name: "mongodb-atlas-online-archive",
+				Type: "mongodb-atlas",
+				Config: configOnlineArchive{
+					ClusterName: archive.ClusterName,
+					Database:    archive.DBName,
+					Collection:  archive.CollName,
+				},
+			}, nil
+		}
+	}
+	return dataSourceOnlineArchive{}, errors.New("failed to find Atlas online archive")
+}
+
+func (i *createInputs) resolveFederatedSearch(ac atlas.Client, groupID string) (dataSourceFederatedSearch, error) {
+	indexes, err := ac.FederatedSearch(groupID)
+	if err != nil {
+		return dataSourceFederatedSearch{}, err
+	}
+
+	for _, index := range indexes {
+		if index.Name == i.FederatedSearch {
+			return dataSourceFederatedSearch{
+				Name: "mongodb-atlas-federated-search",
+				Type: "mongodb-atlas",
+				Config: configFederatedSearch{
+					Database:   index.DBName,
+					Collection: index.CollName,
+					IndexName:  index.Name,
+				},
+			}, nil
+		}
+	}
+	return dataSourceFederatedSearch{}, errors.New("failed to find Atlas federated search index")
+}
+
+type dataSourceCluster struct {
+	Name   string        `json:"name"`
+	Type   string        `json:"type"`
+	Config configCluster `json:"config"`
+}
+
+type configCluster struct {
+	ClusterName         string `json:"clusterName"`
+	ReadPreference      string `json:"readPreference"`
+	WireProtocolEnabled bool   `json:"wireProtocolEnabled"`
+}
+
+type dataSourceDataLake struct {
+	Name   string         `json:"name"`
+	Type   string         `json:"type"`
+	Config configDataLake `json:"config"`
+}
+
+type configDataLake struct {
+	DataLakeName string `json:"dataLakeName"`
+}
+
+type dataSourceServerless struct {
+	Name   string           `json:"name"`
+	Type   string           `json:"type"`
+	Config configServerless `json:"config"`
+}
+
+type configServerless struct {
+	ClusterName string `json:"clusterName"`
+}
+
+type dataSourceOnlineArchive struct {
+	Name   string              `json:"name"`
+	Type   string              `json:"type"`
+	Config configOnlineArchive `json:"config"`
+}
+
+type configOnlineArchive struct {
+	ClusterName string `json:"clusterName"`
+	Database    string `json:"database"`
+	Collection  string `json:"collection"`
+}
+
+type dataSourceFederatedSearch struct {
+	Name   string                `json:"name"`
+	Type   string                `json:"type"`
+	Config configFederatedSearch `json:"config"`
+}
+
+type configFederatedSearch struct {
+	Database   string `json:"database"`
+	Collection string `json:"collection"`
+	IndexName  string `json:"indexName"`
+}
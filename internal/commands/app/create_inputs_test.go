@@ -346,3 +346,148 @@ func TestAppCreateInputsResolveDataLake(t *testing.T) {
 		assert.Equal(t, "123", expectedGroupID)
 	})
 }
+
+func TestAppCreateInputsResolveServerless(t *testing.T) {
+	t.Run("should return data source config of a provided serverless instance", func(t *testing.T) {
+		var expectedGroupID string
+		ac := mock.AtlasClient{}
+		ac.ServerlessFn = func(groupID string) ([]atlas.Serverless, error) {
+			expectedGroupID = groupID
+			return []atlas.Serverless{{Name: "test-serverless"}}, nil
+		}
+
+		inputs := createInputs{newAppInputs: newAppInputs{Name: "test-app"}, Serverless: "test-serverless"}
+
+		ds, err := inputs.resolveServerless(ac, "123")
+		assert.Nil(t, err)
+
+		assert.Equal(t, dataSourceServerless{
+			Name: "mongodb-atlas-serverless",
+			Type: "mongodb-atlas",
+			Config: configServerless{
+				ClusterName: "test-serverless",
+			},
+		}, ds)
+		assert.Equal(t, "123", expectedGroupID)
+	})
+
+	t.Run("should not be able to find specified serverless instance", func(t *testing.T) {
+		ac := mock.AtlasClient{}
+		ac.ServerlessFn = func(groupID string) ([]atlas.Serverless, error) {
+			return nil, nil
+		}
+
+		inputs := createInputs{Serverless: "test-serverless"}
+
+		_, err := inputs.resolveServerless(ac, "123")
+		assert.Equal(t, errors.New("failed to find Atlas serverless instance"), err)
+	})
+
+	t.Run("should error from client", func(t *testing.T) {
+		ac := mock.AtlasClient{}
+		ac.ServerlessFn = func(groupID string) ([]atlas.Serverless, error) {
+			return nil, errors.New("client error")
+		}
+
+		inputs := createInputs{Serverless: "test-serverless"}
+
+		_, err := inputs.resolveServerless(ac, "123")
+		assert.Equal(t, errors.New("client error"), err)
+	})
+}
+
+func TestAppCreateInputsResolveOnlineArchive(t *testing.T) {
+	t.Run("should return data source config of a provided online archive", func(t *testing.T) {
+		ac := mock.AtlasClient{}
+		ac.OnlineArchivesFn = func(groupID, clusterName string) ([]atlas.OnlineArchive, error) {
+			return []atlas.OnlineArchive{{ID: "789", ClusterName: "test-cluster", DBName: "db", CollName: "coll"}}, nil
+		}
+
+		inputs := createInputs{OnlineArchive: "789"}
+
+		ds, err := inputs.resolveOnlineArchive(ac, "123", "test-cluster")
+		assert.Nil(t, err)
+
+		assert.Equal(t, dataSourceOnlineArchive{
+			Name: "mongodb-atlas-online-archive",
+			Type: "mongodb-atlas",
+			Config: configOnlineArchive{
+				ClusterName: "test-cluster",
+				Database:    "db",
+				Collection:  "coll",
+			},
+		}, ds)
+	})
+
+	t.Run("should not be able to find specified online archive", func(t *testing.T) {
+		ac := mock.AtlasClient{}
+		ac.OnlineArchivesFn = func(groupID, clusterName string) ([]atlas.OnlineArchive, error) {
+			return nil, nil
+		}
+
+		inputs := createInputs{OnlineArchive: "789"}
+
+		_, err := inputs.resolveOnlineArchive(ac, "123", "test-cluster")
+		assert.Equal(t, errors.New("failed to find Atlas online archive"), err)
+	})
+
+	t.Run("should error from client", func(t *testing.T) {
+		ac := mock.AtlasClient{}
+		ac.OnlineArchivesFn = func(groupID, clusterName string) ([]atlas.OnlineArchive, error) {
+			return nil, errors.New("client error")
+		}
+
+		inputs := createInputs{OnlineArchive: "789"}
+
+		_, err := inputs.resolveOnlineArchive(ac, "123", "test-cluster")
+		assert.Equal(t, errors.New("client error"), err)
+	})
+}
+
+func TestAppCreateInputsResolveFederatedSearch(t *testing.T) {
+	t.Run("should return data source config of a provided federated search index", func(t *testing.T) {
+		ac := mock.AtlasClient{}
+		ac.FederatedSearchFn = func(groupID string) ([]atlas.FederatedSearchIndex, error) {
+			return []atlas.FederatedSearchIndex{{Name: "test-index", DBName: "db", CollName: "coll"}}, nil
+		}
+
+		inputs := createInputs{FederatedSearch: "test-index"}
+
+		ds, err := inputs.resolveFederatedSearch(ac, "123")
+		assert.Nil(t, err)
+
+		assert.Equal(t, dataSourceFederatedSearch{
+			Name: "mongodb-atlas-federated-search",
+			Type: "mongodb-atlas",
+			Config: configFederatedSearch{
+				Database:   "db",
+				Collection: "coll",
+				IndexName:  "test-index",
+			},
+		}, ds)
+	})
+
+	t.Run("should not be able to find specified federated search index", func(t *testing.T) {
+		ac := mock.AtlasClient{}
+		ac.FederatedSearchFn = func(groupID string) ([]atlas.FederatedSearchIndex, error) {
+			return nil, nil
+		}
+
+		inputs := createInputs{FederatedSearch: "test-index"}
+
+		_, err := inputs.resolveFederatedSearch(ac, "123")
+		assert.Equal(t, errors.New("failed to find Atlas federated search index"), err)
+	})
+
+	t.Run("should error from client", func(t *testing.T) {
+		ac := mock.AtlasClient{}
+		ac.FederatedSearchFn = func(groupID string) ([]atlas.FederatedSearchIndex, error) {
+			return nil, errors.New("client error")
+		}
+
+		inputs := createInputs{FederatedSearch: "test-index"}
+
+		_, err := inputs.resolveFederatedSearch(ac, "123")
+		assert.Equal(t, errors.New("client error"), err)
+	})
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"fmt"
 	"os"
 	"strings"
 
@@ -33,6 +34,7 @@ type diffInputs struct {
 	LocalPath           string
 	IncludeDependencies bool
 	IncludeHosting      bool
+	Format              string
 }
 
 const (
@@ -44,6 +46,10 @@ const (
 	flagIncludeHosting           = "include-hosting"
 	flagIncludeHostingShort      = "s"
 	flagIncludeHostingUsage      = "include to diff Realm app hosting changes as well"
+	flagFormat                   = "format"
+	flagFormatUsage              = "set the output format, one of: text, json, patch"
+
+	flagFormatDefault = diffFormatText
 )
 
 // Flags is the command flags
@@ -53,6 +59,7 @@ func (cmd *CommandDiff) Flags(fs *pflag.FlagSet) {
 	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPathDiff, "", flagLocalPathDiffUsage)
 	fs.BoolVarP(&cmd.inputs.IncludeDependencies, flagIncludeDependencies, flagIncludeDependenciesShort, false, flagIncludeDependenciesUsage)
 	fs.BoolVarP(&cmd.inputs.IncludeHosting, flagIncludeHosting, flagIncludeHostingShort, false, flagIncludeHostingUsage)
+	fs.StringVar(&cmd.inputs.Format, flagFormat, flagFormatDefault, flagFormatUsage)
 }
 
 // Inputs is the command inputs
@@ -88,7 +95,7 @@ func (cmd *CommandDiff) Handler(profile *user.Profile, ui terminal.UI, clients c
 		if err != nil {
 			return err
 		}
-		diffs = append(diffs, dependenciesDiff.Strings()...)
+		diffs = append(diffs, dependenciesDiff...)
 	}
 
 	if cmd.inputs.IncludeHosting {
@@ -107,7 +114,7 @@ func (cmd *CommandDiff) Handler(profile *user.Profile, ui terminal.UI, clients c
 			return err
 		}
 
-		diffs = append(diffs, hostingDiffs.Strings()...)
+		diffs = append(diffs, hostingDiffs...)
 	}
 
 	if len(diffs) == 0 {
@@ -116,10 +123,21 @@ func (cmd *CommandDiff) Handler(profile *user.Profile, ui terminal.UI, clients c
 		return nil
 	}
 
-	ui.Print(terminal.NewTextLog(
-		"The following reflects the proposed changes to your Realm app\n%s",
-		strings.Join(diffs, "\n"),
-	))
+	switch cmd.inputs.Format {
+	case diffFormatJSON:
+		out, err := renderDiffJSON(diffs)
+		if err != nil {
+			return err
+		}
+		ui.Print(terminal.NewTextLog(out))
+	case diffFormatPatch:
+		ui.Print(terminal.NewTextLog(renderDiffPatch(diffs)))
+	default:
+		ui.Print(terminal.NewTextLog(
+			"The following reflects the proposed changes to your Realm app\n%s",
+			renderDiffText(diffs),
+		))
+	}
 
 	return nil
 }
@@ -132,5 +150,17 @@ func (i *diffInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
 	if i.LocalPath == "" {
 		i.LocalPath = profile.WorkingDirectory
 	}
+
+	validFormat := false
+	for _, format := range diffFormats {
+		if i.Format == format {
+			validFormat = true
+			break
+		}
+	}
+	if !validFormat {
+		return fmt.Errorf("unsupported --format %q, must be one of: %s", i.Format, strings.Join(diffFormats, ", "))
+	}
+
 	return nil
 }
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/10gen/realm-cli/internal/cloud/realm"
+)
+
+// set of supported `app diff` output formats
+const (
+	diffFormatText  = "text"
+	diffFormatJSON  = "json"
+	diffFormatPatch = "patch"
+)
+
+var diffFormats = []string{diffFormatText, diffFormatJSON, diffFormatPatch}
+
+func renderDiffText(diffs realm.Diffs) string {
+	return strings.Join(diffs.Strings(), "\n")
+}
+
+func renderDiffJSON(diffs realm.Diffs) (string, error) {
+	data, err := json.MarshalIndent(diffs, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func renderDiffPatch(diffs realm.Diffs) string {
+	var sb strings.Builder
+	for _, diff := range diffs {
+		hunks := diff.HunksOrComputed()
+		if len(hunks) == 0 {
+			if diff.Text != "" {
+				writeTextOnlyDiff(&sb, diff)
+			}
+			continue
+		}
+
+		fmt.Fprintf(&sb, "--- a/%s\n", diff.Path)
+		fmt.Fprintf(&sb, "+++ b/%s\n", diff.Path)
+		for _, hunk := range hunks {
+			fmt.Fprintln(&sb, hunk.Header)
+			for _, line := range hunk.Lines {
+				fmt.Fprintln(&sb, line)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// writeTextOnlyDiff records a diff that only ever carried a human-readable Text
+// summary (e.g. a dependency or hosting diff, which have no Before/After to
+// derive a real hunk from). It's written as a comment block so `git apply`
+// skips over it rather than choking on invalid hunk syntax, while still
+// surfacing the change to anyone reading the patch output.
+func writeTextOnlyDiff(sb *strings.Builder, diff realm.Diff) {
+	fmt.Fprintf(sb, "# %s (no line-level diff available)\n", diff.Path)
+	for _, line := range strings.Split(diff.Text, "\n") {
+		fmt.Fprintf(sb, "# %s\n", line)
+	}
+}
@@ -0,0 +1,96 @@
+package operator
+
+import (
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/local/operator"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaApply is the command meta for the `app operator apply` command
+var CommandMetaApply = cli.CommandMeta{
+	Use:         "apply",
+	Aliases:     []string{},
+	Display:     "app operator apply",
+	Description: "Apply MongoDB Atlas Kubernetes Operator manifests for your Realm app",
+	HelpText: `Translates your local Realm app into MongoDB Atlas/Realm Kubernetes Custom
+Resources and applies them directly to the cluster referenced by --kubeconfig.`,
+}
+
+// CommandApply is the `app operator apply` command
+type CommandApply struct {
+	inputs applyInputs
+}
+
+type applyInputs struct {
+	cli.ProjectInputs
+	LocalPath      string
+	KubeconfigPath string
+	ProjectName    string
+	Namespace      string
+}
+
+const (
+	flagLocalPathApply      = "local"
+	flagLocalPathApplyUsage = "the local path to your Realm app"
+	flagKubeconfig          = "kubeconfig"
+	flagKubeconfigUsage     = "the path to the kubeconfig file to apply manifests with"
+	flagNamespace           = "namespace"
+	flagNamespaceUsage      = "the namespace to apply the generated custom resources to"
+
+	flagNamespaceDefault = "default"
+)
+
+// Flags is the command flags
+func (cmd *CommandApply) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPathApply, "", flagLocalPathApplyUsage)
+	fs.StringVar(&cmd.inputs.KubeconfigPath, flagKubeconfig, "", flagKubeconfigUsage)
+	fs.StringVar(&cmd.inputs.ProjectName, flagProjectName, "", flagProjectNameUsage)
+	fs.StringVar(&cmd.inputs.Namespace, flagNamespace, flagNamespaceDefault, flagNamespaceUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandApply) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandApply) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := local.LoadApp(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+
+	projectName := cmd.inputs.ProjectName
+	if projectName == "" {
+		projectName = app.AppData.Name()
+	}
+
+	resources, err := operator.Translate(app, projectName, cmd.inputs.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if err := operator.Apply(cmd.inputs.KubeconfigPath, cmd.inputs.Namespace, resources); err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog("Applied %d manifests to the cluster", len(resources)))
+	return nil
+}
+
+func (i *applyInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true); err != nil {
+		return err
+	}
+
+	if i.LocalPath == "" {
+		i.LocalPath = profile.WorkingDirectory
+	}
+	return nil
+}
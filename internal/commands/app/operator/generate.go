@@ -0,0 +1,99 @@
+package operator
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/cli"
+	"github.com/10gen/realm-cli/internal/cli/user"
+	"github.com/10gen/realm-cli/internal/local"
+	"github.com/10gen/realm-cli/internal/local/operator"
+	"github.com/10gen/realm-cli/internal/terminal"
+
+	"github.com/spf13/pflag"
+)
+
+// CommandMetaGenerate is the command meta for the `app operator generate` command
+var CommandMetaGenerate = cli.CommandMeta{
+	Use:         "generate",
+	Aliases:     []string{},
+	Display:     "app operator generate",
+	Description: "Generate MongoDB Atlas Kubernetes Operator manifests for your Realm app",
+	HelpText: `Translates your local Realm app into MongoDB Atlas/Realm Kubernetes Custom
+Resource manifests, writing one YAML file per resource to the output directory.`,
+}
+
+// CommandGenerate is the `app operator generate` command
+type CommandGenerate struct {
+	inputs generateInputs
+}
+
+type generateInputs struct {
+	cli.ProjectInputs
+	LocalPath   string
+	OutputDir   string
+	ProjectName string
+	Namespace   string
+}
+
+const (
+	flagLocalPathGenerate      = "local"
+	flagLocalPathGenerateUsage = "the local path to your Realm app"
+	flagOutputDir              = "output-dir"
+	flagOutputDirUsage         = "the directory to write the generated manifests to"
+	flagProjectName            = "project-name"
+	flagProjectNameUsage       = "the name of the AtlasProject custom resource to reference"
+
+	flagOutputDirDefault = "operator"
+)
+
+// Flags is the command flags
+func (cmd *CommandGenerate) Flags(fs *pflag.FlagSet) {
+	cmd.inputs.Flags(fs)
+
+	fs.StringVar(&cmd.inputs.LocalPath, flagLocalPathGenerate, "", flagLocalPathGenerateUsage)
+	fs.StringVar(&cmd.inputs.OutputDir, flagOutputDir, flagOutputDirDefault, flagOutputDirUsage)
+	fs.StringVar(&cmd.inputs.ProjectName, flagProjectName, "", flagProjectNameUsage)
+	fs.StringVar(&cmd.inputs.Namespace, flagNamespace, flagNamespaceDefault, flagNamespaceUsage)
+}
+
+// Inputs is the command inputs
+func (cmd *CommandGenerate) Inputs() cli.InputResolver {
+	return &cmd.inputs
+}
+
+// Handler is the command handler
+func (cmd *CommandGenerate) Handler(profile *user.Profile, ui terminal.UI, clients cli.Clients) error {
+	app, err := local.LoadApp(cmd.inputs.LocalPath)
+	if err != nil {
+		return err
+	}
+
+	projectName := cmd.inputs.ProjectName
+	if projectName == "" {
+		projectName = app.AppData.Name()
+	}
+
+	resources, err := operator.Translate(app, projectName, cmd.inputs.Namespace)
+	if err != nil {
+		return err
+	}
+
+	paths, err := operator.WriteManifests(cmd.inputs.OutputDir, resources)
+	if err != nil {
+		return err
+	}
+
+	ui.Print(terminal.NewTextLog(fmt.Sprintf("Wrote %d manifests to %s", len(paths), cmd.inputs.OutputDir)))
+	return nil
+}
+
+func (i *generateInputs) Resolve(profile *user.Profile, ui terminal.UI) error {
+	if err := i.ProjectInputs.Resolve(ui, profile.WorkingDirectory, true); err != nil {
+		return err
+	}
+
+	if i.LocalPath == "" {
+		i.LocalPath = profile.WorkingDirectory
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const fieldManager = "realm-cli"
+
+// Apply applies the given resources, which must already be stamped with a
+// namespace (see Translate), to the cluster referenced by kubeconfigPath.
+func Apply(kubeconfigPath string, namespace string, resources []Resource) error {
+	config, err := clientcmd.BuildConfigFromFlags("", kubeconfigPath)
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("failed to create kubernetes client: %w", err)
+	}
+
+	ctx := context.Background()
+	for _, resource := range resources {
+		gvr, obj, err := toUnstructured(resource)
+		if err != nil {
+			return err
+		}
+
+		applyOpts := metav1.ApplyOptions{FieldManager: fieldManager, Force: true}
+		if _, err := client.Resource(gvr).Namespace(namespace).Apply(ctx, obj.GetName(), obj, applyOpts); err != nil {
+			return fmt.Errorf("failed to apply %s %q: %w", resource.Kind, resource.Metadata.Name, err)
+		}
+	}
+	return nil
+}
+
+func toUnstructured(resource Resource) (schema.GroupVersionResource, *unstructured.Unstructured, error) {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&resource)
+	if err != nil {
+		return schema.GroupVersionResource{}, nil, err
+	}
+
+	gv, err := schema.ParseGroupVersion(resource.APIVersion)
+	if err != nil {
+		return schema.GroupVersionResource{}, nil, err
+	}
+
+	unstructuredObj := &unstructured.Unstructured{Object: obj}
+	return gv.WithResource(strings.ToLower(resource.Kind) + "s"), unstructuredObj, nil
+}
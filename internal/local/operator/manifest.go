@@ -0,0 +1,34 @@
+package operator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// WriteManifests renders the given resources as YAML and writes one file per
+// resource into outputDir, named "<kind>-<name>.yaml" (lowercased).
+func WriteManifests(outputDir string, resources []Resource) ([]string, error) {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		data, err := yaml.Marshal(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		filename := strings.ToLower(fmt.Sprintf("%s-%s.yaml", resource.Kind, resource.Metadata.Name))
+		path := filepath.Join(outputDir, filename)
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
@@ -0,0 +1,38 @@
+package operator
+
+// Resource is a MongoDB Atlas/Realm Kubernetes Custom Resource manifest
+type Resource struct {
+	APIVersion string                 `json:"apiVersion"`
+	Kind       string                 `json:"kind"`
+	Metadata   ResourceMetadata       `json:"metadata"`
+	Spec       map[string]interface{} `json:"spec"`
+}
+
+// ResourceMetadata is the standard Kubernetes object metadata included on every Resource
+type ResourceMetadata struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+const (
+	apiVersionAtlas = "atlas.mongodb.com/v1"
+	apiVersionRealm = "realm.mongodb.com/v1"
+
+	kindAtlasProject      = "AtlasProject"
+	kindAtlasCluster      = "AtlasDeployment"
+	kindRealmApp          = "RealmApp"
+	kindRealmSecret       = "RealmSecret"
+	kindRealmFunction     = "RealmFunction"
+	kindRealmTrigger      = "RealmTrigger"
+	kindRealmAuthProvider = "RealmAuthProvider"
+)
+
+func newResource(apiVersion, kind, name string, spec map[string]interface{}) Resource {
+	return Resource{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Metadata:   ResourceMetadata{Name: name},
+		Spec:       spec,
+	}
+}
@@ -0,0 +1,105 @@
+package operator
+
+import (
+	"fmt"
+
+	"github.com/10gen/realm-cli/internal/local"
+)
+
+// Translate converts a loaded Realm app into the set of MongoDB Atlas/Realm
+// Kubernetes Custom Resources needed to recreate it via the Atlas Kubernetes Operator.
+// The returned resources are namespace-scoped CRs and are stamped with namespace.
+func Translate(app local.App, projectName, namespace string) ([]Resource, error) {
+	if projectName == "" {
+		return nil, fmt.Errorf("operator: project name must not be empty")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("operator: namespace must not be empty")
+	}
+
+	resources := []Resource{newAtlasProject(projectName)}
+
+	for _, ds := range app.AppData.DataSources() {
+		resources = append(resources, newAtlasDeployment(projectName, ds))
+	}
+
+	resources = append(resources, newRealmApp(app, projectName))
+
+	for _, secret := range app.AppData.Secrets() {
+		resources = append(resources, newRealmSecret(app.AppData.Name(), secret))
+	}
+
+	for _, fn := range app.AppData.Functions() {
+		resources = append(resources, newRealmFunction(app.AppData.Name(), fn))
+	}
+
+	for _, trigger := range app.AppData.Triggers() {
+		resources = append(resources, newRealmTrigger(app.AppData.Name(), trigger))
+	}
+
+	for _, authProvider := range app.AppData.AuthProviders() {
+		resources = append(resources, newRealmAuthProvider(app.AppData.Name(), authProvider))
+	}
+
+	for i := range resources {
+		resources[i].Metadata.Namespace = namespace
+	}
+
+	return resources, nil
+}
+
+func newAtlasProject(name string) Resource {
+	return newResource(apiVersionAtlas, kindAtlasProject, name, map[string]interface{}{
+		"name": name,
+	})
+}
+
+func newAtlasDeployment(projectName string, ds local.DataSource) Resource {
+	// ds.Name() is the Realm data source link alias (e.g. "mongodb-atlas"), not the
+	// Atlas cluster it points at; the CR must reference the real cluster name.
+	clusterName := ds.ClusterName()
+	return newResource(apiVersionAtlas, kindAtlasCluster, clusterName, map[string]interface{}{
+		"projectRef": map[string]interface{}{"name": projectName},
+		"deploymentSpec": map[string]interface{}{
+			"name": clusterName,
+		},
+	})
+}
+
+func newRealmApp(app local.App, projectName string) Resource {
+	return newResource(apiVersionRealm, kindRealmApp, app.AppData.Name(), map[string]interface{}{
+		"projectRef":      map[string]interface{}{"name": projectName},
+		"name":            app.AppData.Name(),
+		"deploymentModel": app.AppData.DeploymentModel(),
+		"location":        app.AppData.Location(),
+		"environment":     app.AppData.Environment(),
+	})
+}
+
+func newRealmSecret(appName string, secret local.Secret) Resource {
+	return newResource(apiVersionRealm, kindRealmSecret, fmt.Sprintf("%s-%s", appName, secret.Name()), map[string]interface{}{
+		"name": secret.Name(),
+	})
+}
+
+func newRealmFunction(appName string, fn local.Function) Resource {
+	return newResource(apiVersionRealm, kindRealmFunction, fmt.Sprintf("%s-%s", appName, fn.Name()), map[string]interface{}{
+		"name":   fn.Name(),
+		"source": fn.Source(),
+	})
+}
+
+func newRealmTrigger(appName string, trigger local.Trigger) Resource {
+	return newResource(apiVersionRealm, kindRealmTrigger, fmt.Sprintf("%s-%s", appName, trigger.Name()), map[string]interface{}{
+		"name": trigger.Name(),
+		"type": trigger.Type(),
+	})
+}
+
+func newRealmAuthProvider(appName string, provider local.AuthProvider) Resource {
+	return newResource(apiVersionRealm, kindRealmAuthProvider, fmt.Sprintf("%s-%s", appName, provider.Name()), map[string]interface{}{
+		"name":    provider.Name(),
+		"type":    provider.Type(),
+		"enabled": provider.Enabled(),
+	})
+}
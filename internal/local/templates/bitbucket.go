@@ -0,0 +1,62 @@
+package templates
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+)
+
+// BitbucketProvider fetches templates from a Bitbucket repo via the REST API
+type BitbucketProvider struct {
+	org, repo, token string
+	httpClient       *http.Client
+}
+
+// NewBitbucketProvider creates a BitbucketProvider for the given org/repo, authenticating
+// with token if set
+func NewBitbucketProvider(org, repo, token string) *BitbucketProvider {
+	return &BitbucketProvider{org, repo, token, http.DefaultClient}
+}
+
+// ListTemplates reads realm-templates.json from the repo root, if present
+func (p *BitbucketProvider) ListTemplates() ([]Template, error) {
+	reqURL := fmt.Sprintf("https://api.bitbucket.org/2.0/repositories/%s/%s/src/main/realm-templates.json", p.org, p.repo)
+	return listTemplatesFromJSON(p.do(reqURL))
+}
+
+// Fetch downloads the repo archive at ref and returns the contents rooted at subpath
+func (p *BitbucketProvider) Fetch(ref, subpath string) (fs.FS, error) {
+	if ref == "" {
+		ref = "main"
+	}
+
+	reqURL := fmt.Sprintf("https://bitbucket.org/%s/%s/get/%s.tar.gz", p.org, p.repo, ref)
+	body, err := p.do(reqURL)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return extractTarGz(body, subpath)
+}
+
+func (p *BitbucketProvider) do(reqURL string) (io.ReadCloser, error) {
+	req, err := http.NewRequest(http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
+		return nil, fmt.Errorf("bitbucket: unexpected status %d fetching %s", res.StatusCode, reqURL)
+	}
+	return res.Body, nil
+}
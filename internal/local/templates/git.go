@@ -0,0 +1,86 @@
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// GitProvider fetches templates from an arbitrary git remote URL via git clone,
+// for sources not covered by the GitHub/GitLab/Bitbucket REST APIs
+type GitProvider struct {
+	url, token string
+}
+
+// NewGitProvider creates a GitProvider for the given remote URL, authenticating
+// with token if set
+func NewGitProvider(url, token string) *GitProvider {
+	return &GitProvider{url, token}
+}
+
+// ListTemplates reads realm-templates.json from the repo root, if present
+func (p *GitProvider) ListTemplates() ([]Template, error) {
+	dir, err := p.clone("")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	f, err := os.Open(filepath.Join(dir, "realm-templates.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var templates []Template
+	if err := json.NewDecoder(f).Decode(&templates); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// Fetch clones the repo at ref and returns the contents rooted at subpath
+func (p *GitProvider) Fetch(ref, subpath string) (fs.FS, error) {
+	dir, err := p.clone(ref)
+	if err != nil {
+		return nil, err
+	}
+	return os.DirFS(filepath.Join(dir, subpath)), nil
+}
+
+func (p *GitProvider) clone(ref string) (string, error) {
+	dir, err := os.MkdirTemp("", "realm-template-")
+	if err != nil {
+		return "", err
+	}
+
+	args := []string{"clone", "--depth", "1"}
+	if ref != "" {
+		args = append(args, "--branch", ref)
+	}
+	args = append(args, p.authenticatedURL(), dir)
+
+	cmd := exec.Command("git", args...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		os.RemoveAll(dir)
+		return "", fmt.Errorf("git: failed to clone %s: %w (%s)", p.url, err, strings.TrimSpace(string(out)))
+	}
+
+	return dir, nil
+}
+
+// authenticatedURL injects the token as basic auth credentials for https remotes,
+// leaving ssh/git remotes (which authenticate via the local agent) untouched
+func (p *GitProvider) authenticatedURL() string {
+	if p.token == "" || !strings.HasPrefix(p.url, "https://") {
+		return p.url
+	}
+	return "https://" + p.token + "@" + strings.TrimPrefix(p.url, "https://")
+}
@@ -0,0 +1,147 @@
+package templates
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// GitHubProvider fetches templates from a GitHub repo via the REST API
+type GitHubProvider struct {
+	org, repo, token string
+	httpClient       *http.Client
+}
+
+// NewGitHubProvider creates a GitHubProvider for the given org/repo, authenticating
+// with token if set
+func NewGitHubProvider(org, repo, token string) *GitHubProvider {
+	return &GitHubProvider{org, repo, token, http.DefaultClient}
+}
+
+// ListTemplates reads realm-templates.json from the repo root, if present
+func (p *GitHubProvider) ListTemplates() ([]Template, error) {
+	url := fmt.Sprintf("https://api.github.com/repos/%s/%s/contents/realm-templates.json", p.org, p.repo)
+	return listTemplatesFromJSON(p.do(url))
+}
+
+// Fetch downloads the repo tarball at ref and returns the contents rooted at subpath
+func (p *GitHubProvider) Fetch(ref, subpath string) (fs.FS, error) {
+	if ref == "" {
+		ref = "main"
+	}
+
+	url := fmt.Sprintf("https://api.github.com/repos/%s/%s/tarball/%s", p.org, p.repo, ref)
+	body, err := p.do(url)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return extractTarGz(body, subpath)
+}
+
+func (p *GitHubProvider) do(url string) (io.ReadCloser, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if p.token != "" {
+		req.Header.Set("Authorization", "token "+p.token)
+	}
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
+		return nil, fmt.Errorf("github: unexpected status %d fetching %s", res.StatusCode, url)
+	}
+	return res.Body, nil
+}
+
+func listTemplatesFromJSON(body io.ReadCloser, err error) ([]Template, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var templates []Template
+	if err := json.NewDecoder(body).Decode(&templates); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// extractTarGz extracts a gzip-compressed tarball into a temp directory, stripping
+// the single top-level directory GitHub/GitLab/Bitbucket tarballs wrap the tree in,
+// then returns the subpath within it as an fs.FS.
+func extractTarGz(r io.Reader, subpath string) (fs.FS, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gzr.Close()
+
+	dir, err := os.MkdirTemp("", "realm-template-")
+	if err != nil {
+		return nil, err
+	}
+
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		name := stripTopLevelDir(header.Name)
+		if name == "" {
+			continue
+		}
+		target := filepath.Join(dir, name)
+		if target != dir && !strings.HasPrefix(target, dir+string(os.PathSeparator)) {
+			return nil, fmt.Errorf("github: tarball entry %q escapes extraction directory", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return nil, err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return nil, err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY, os.FileMode(header.Mode))
+			if err != nil {
+				return nil, err
+			}
+			if _, err := io.Copy(f, tr); err != nil {
+				f.Close()
+				return nil, err
+			}
+			f.Close()
+		}
+	}
+
+	return os.DirFS(filepath.Join(dir, subpath)), nil
+}
+
+func stripTopLevelDir(name string) string {
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
@@ -0,0 +1,65 @@
+package templates
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+)
+
+// GitLabProvider fetches templates from a GitLab project via the REST API
+type GitLabProvider struct {
+	org, repo, token string
+	httpClient       *http.Client
+}
+
+// NewGitLabProvider creates a GitLabProvider for the given org/repo, authenticating
+// with token if set
+func NewGitLabProvider(org, repo, token string) *GitLabProvider {
+	return &GitLabProvider{org, repo, token, http.DefaultClient}
+}
+
+// ListTemplates reads realm-templates.json from the project root, if present
+func (p *GitLabProvider) ListTemplates() ([]Template, error) {
+	projectID := url.QueryEscape(p.org + "/" + p.repo)
+	reqURL := fmt.Sprintf("https://gitlab.com/api/v4/projects/%s/repository/files/realm-templates.json/raw?ref=main", projectID)
+	return listTemplatesFromJSON(p.do(reqURL))
+}
+
+// Fetch downloads the project archive at ref and returns the contents rooted at subpath
+func (p *GitLabProvider) Fetch(ref, subpath string) (fs.FS, error) {
+	if ref == "" {
+		ref = "main"
+	}
+
+	projectID := url.QueryEscape(p.org + "/" + p.repo)
+	reqURL := fmt.Sprintf("https://gitlab.com/api/v4/projects/%s/repository/archive.tar.gz?sha=%s", projectID, url.QueryEscape(ref))
+	body, err := p.do(reqURL)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return extractTarGz(body, subpath)
+}
+
+func (p *GitLabProvider) do(reqURL string) (io.ReadCloser, error) {
+	req, err := http.NewRequest(http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if p.token != "" {
+		req.Header.Set("PRIVATE-TOKEN", p.token)
+	}
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
+		return nil, fmt.Errorf("gitlab: unexpected status %d fetching %s", res.StatusCode, reqURL)
+	}
+	return res.Body, nil
+}
@@ -0,0 +1,75 @@
+package templates
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Vars are the substitution variables applied to realm_config.json after a
+// template is overlaid onto a scaffolded app
+type Vars struct {
+	AppName      string
+	ClusterName  string
+	DataLakeName string
+}
+
+// Overlay copies every file in templateFS onto destDir, then substitutes Vars
+// placeholders (e.g. "{{app_name}}") into destDir/realm_config.json
+func Overlay(templateFS fs.FS, destDir string, vars Vars) error {
+	if err := fs.WalkDir(templateFS, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == "." {
+			return nil
+		}
+
+		target := filepath.Join(destDir, path)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+
+		src, err := templateFS.Open(path)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		dst, err := os.Create(target)
+		if err != nil {
+			return err
+		}
+		defer dst.Close()
+
+		_, err = io.Copy(dst, src)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	return substituteVars(filepath.Join(destDir, "realm_config.json"), vars)
+}
+
+func substituteVars(path string, vars Vars) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	replacer := strings.NewReplacer(
+		"{{app_name}}", vars.AppName,
+		"{{cluster_name}}", vars.ClusterName,
+		"{{data_lake_name}}", vars.DataLakeName,
+	)
+
+	return os.WriteFile(path, []byte(replacer.Replace(string(data))), 0644)
+}
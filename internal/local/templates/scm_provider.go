@@ -0,0 +1,98 @@
+package templates
+
+import (
+	"fmt"
+	"io/fs"
+	"regexp"
+	"strings"
+)
+
+// Template describes a single app template a Provider can fetch
+type Template struct {
+	Name        string
+	Description string
+	Ref         string
+	Subpath     string
+}
+
+// Provider fetches app template trees from a git-hosted source control provider
+type Provider interface {
+	// ListTemplates returns the templates available from this provider
+	ListTemplates() ([]Template, error)
+	// Fetch downloads the tree at ref/subpath and returns it as an fs.FS
+	Fetch(ref, subpath string) (fs.FS, error)
+}
+
+// Ref identifies a template source parsed from a --from flag value, e.g.
+// "github://org/repo@ref/subpath" or a plain git URL such as
+// "https://example.com/org/repo.git@ref"
+type Ref struct {
+	Scheme  string
+	Org     string
+	Repo    string
+	URL     string
+	GitRef  string
+	Subpath string
+}
+
+var refPattern = regexp.MustCompile(`^(github|gitlab|bitbucket)://([^/]+)/([^@/]+)(?:@([^/]+))?(?:/(.+))?$`)
+
+// gitURLPattern matches a plain git remote URL, e.g. https://, ssh://, or the
+// scp-like git@host:path form
+var gitURLPattern = regexp.MustCompile(`^(https?://|ssh://|git@)`)
+
+// ParseRef parses a --from flag value into a Ref
+func ParseRef(from string) (Ref, error) {
+	if matches := refPattern.FindStringSubmatch(from); matches != nil {
+		return Ref{
+			Scheme:  matches[1],
+			Org:     matches[2],
+			Repo:    matches[3],
+			GitRef:  matches[4],
+			Subpath: matches[5],
+		}, nil
+	}
+
+	if gitURLPattern.MatchString(from) {
+		url, gitRef := splitGitRef(from)
+		return Ref{Scheme: "git", URL: url, GitRef: gitRef}, nil
+	}
+
+	return Ref{}, fmt.Errorf("invalid --from value %q, expected scheme://org/repo[@ref][/subpath] or a git URL", from)
+}
+
+// splitGitRef splits a trailing "@ref" off a plain git URL, taking care not to
+// confuse it with the user info "@" of the "git@host:path" scp-like form or of
+// a "scheme://user@host/path" URL
+func splitGitRef(raw string) (url, ref string) {
+	prefix := ""
+	rest := raw
+	if strings.HasPrefix(raw, "git@") {
+		prefix, rest = "git@", raw[len("git@"):]
+	} else if i := strings.Index(raw, "://"); i >= 0 {
+		if slash := strings.Index(raw[i+3:], "/"); slash >= 0 {
+			prefix, rest = raw[:i+3+slash], raw[i+3+slash:]
+		}
+	}
+
+	if idx := strings.LastIndex(rest, "@"); idx >= 0 {
+		return prefix + rest[:idx], rest[idx+1:]
+	}
+	return raw, ""
+}
+
+// NewProvider returns the Provider implementation for the given Ref's scheme
+func NewProvider(ref Ref, token string) (Provider, error) {
+	switch ref.Scheme {
+	case "github":
+		return NewGitHubProvider(ref.Org, ref.Repo, token), nil
+	case "gitlab":
+		return NewGitLabProvider(ref.Org, ref.Repo, token), nil
+	case "bitbucket":
+		return NewBitbucketProvider(ref.Org, ref.Repo, token), nil
+	case "git":
+		return NewGitProvider(ref.URL, token), nil
+	default:
+		return nil, fmt.Errorf("unsupported template provider scheme %q", ref.Scheme)
+	}
+}
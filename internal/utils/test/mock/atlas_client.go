@@ -1,13 +1,21 @@
 package mock
 
-import "github.com/10gen/realm-cli/internal/cloud/atlas"
+import (
+	"time"
+
+	"github.com/10gen/realm-cli/internal/cloud/atlas"
+)
 
 // AtlasClient is a mocked Atlas client
 type AtlasClient struct {
 	atlas.Client
-	GroupsFn    func() ([]atlas.Group, error)
-	ClustersFn  func(groupID string) ([]atlas.Cluster, error)
-	DataLakesFn func(groupID string) ([]atlas.DataLake, error)
+	GroupsFn          func() ([]atlas.Group, error)
+	ClustersFn        func(groupID string) ([]atlas.Cluster, error)
+	DataLakesFn       func(groupID string) ([]atlas.DataLake, error)
+	ServerlessFn      func(groupID string) ([]atlas.Serverless, error)
+	ProjectSettingsFn func(groupID string) (atlas.ProjectSettings, error)
+	OnlineArchivesFn  func(groupID, clusterName string) ([]atlas.OnlineArchive, error)
+	FederatedSearchFn func(groupID string) ([]atlas.FederatedSearchIndex, error)
 }
 
 // Groups calls the mocked Groups implementation if provided,
@@ -39,3 +47,58 @@ func (ac AtlasClient) DataLakes(groupID string) ([]atlas.DataLake, error) {
 	}
 	return ac.Client.DataLakes(groupID)
 }
+
+// Serverless calls the mocked Serverless implementation if provided,
+// otherwise the call falls back to the underlying atlas.Client implementation.
+// NOTE: this may panic if the underlying atlas.Client is left undefined
+func (ac AtlasClient) Serverless(groupID string) ([]atlas.Serverless, error) {
+	if ac.ServerlessFn != nil {
+		return ac.ServerlessFn(groupID)
+	}
+	return ac.Client.Serverless(groupID)
+}
+
+// ProjectSettings calls the mocked ProjectSettings implementation if provided,
+// otherwise the call falls back to the underlying atlas.Client implementation.
+// NOTE: this may panic if the underlying atlas.Client is left undefined
+func (ac AtlasClient) ProjectSettings(groupID string) (atlas.ProjectSettings, error) {
+	if ac.ProjectSettingsFn != nil {
+		return ac.ProjectSettingsFn(groupID)
+	}
+	return ac.Client.ProjectSettings(groupID)
+}
+
+// OnlineArchives calls the mocked OnlineArchives implementation if provided,
+// otherwise the call falls back to the underlying atlas.Client implementation.
+// NOTE: this may panic if the underlying atlas.Client is left undefined
+func (ac AtlasClient) OnlineArchives(groupID, clusterName string) ([]atlas.OnlineArchive, error) {
+	if ac.OnlineArchivesFn != nil {
+		return ac.OnlineArchivesFn(groupID, clusterName)
+	}
+	return ac.Client.OnlineArchives(groupID, clusterName)
+}
+
+// FederatedSearch calls the mocked FederatedSearch implementation if provided,
+// otherwise the call falls back to the underlying atlas.Client implementation.
+// NOTE: this may panic if the underlying atlas.Client is left undefined
+func (ac AtlasClient) FederatedSearch(groupID string) ([]atlas.FederatedSearchIndex, error) {
+	if ac.FederatedSearchFn != nil {
+		return ac.FederatedSearchFn(groupID)
+	}
+	return ac.Client.FederatedSearch(groupID)
+}
+
+// NewAtlasClientWithMiddleware wraps ac with the same recovery/retry middleware
+// chain production code builds with atlas.NewClientWithMiddleware (by default
+// with a 100ms retry base delay rather than production's 500ms), so tests can
+// assert retry/recovery behavior deterministically instead of relying on the
+// embedded atlas.Client panicking when left undefined.
+func NewAtlasClientWithMiddleware(ac AtlasClient, interceptors ...atlas.Interceptor) atlas.Client {
+	if len(interceptors) == 0 {
+		interceptors = []atlas.Interceptor{
+			atlas.RecoveryInterceptor(),
+			atlas.RetryInterceptor(3, 100*time.Millisecond),
+		}
+	}
+	return atlas.NewClientWithMiddleware(ac, interceptors...)
+}